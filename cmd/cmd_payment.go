@@ -4,13 +4,56 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 
+	gnfdclient "github.com/bnb-chain/greenfield-go-sdk/client"
 	sdktypes "github.com/bnb-chain/greenfield-go-sdk/types"
 	"github.com/bnb-chain/greenfield/sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/urfave/cli/v2"
 )
 
+const (
+	quotaModeFlag  = "quotaMode"
+	dryRunFlag     = "dryRun"
+	thresholdFlag  = "threshold"
+	storageCapFlag = "storageCap"
+
+	// quotaModeHard is recognized by name only so buy-quota can reject it with a clear "not
+	// supported yet" error instead of silently persisting a mode nothing enforces: no command in
+	// this build rejects uploads that would exceed a storage cap.
+	quotaModeHard = "hard"
+	// quotaModeFIFO marks a bucket as managed by bucket-gc, which reclaims storage from the oldest
+	// objects once total object size gets close to --storageCap.
+	quotaModeFIFO = "fifo"
+
+	// quotaModeTagKey is the bucket tag key used to persist the configured quota mode off-chain.
+	quotaModeTagKey = "quota-mode"
+	// storageCapTagKey is the bucket tag key used to persist the configured storage cap off-chain,
+	// so bucket-gc doesn't need --storageCap passed on every invocation.
+	storageCapTagKey = "storage-cap"
+
+	// defaultGCThreshold is the default high-water mark used by bucket-gc to decide how much to reclaim.
+	defaultGCThreshold = 0.95
+
+	globalFlag    = "global"
+	sizeFlag      = "size"
+	readQuotaFlag = "readQuota"
+	monthsFlag    = "months"
+
+	deltaFlag                = "delta"
+	topUpToConsumedRatioFlag = "topUpToConsumedRatio"
+	broadcastModeFlag        = "broadcastMode"
+
+	broadcastModeSync  = "sync"
+	broadcastModeAsync = "async"
+	broadcastModeBlock = "block"
+)
+
 // cmdGetQuotaPrice query the quota price of the specific sp
 func cmdGetQuotaPrice() *cli.Command {
 	return &cli.Command{
@@ -19,12 +62,57 @@ func cmdGetQuotaPrice() *cli.Command {
 		Usage:     "get the quota price of the SP",
 		ArgsUsage: "",
 		Description: `
-Get the quota price of the specific sp, the command need to set the sp address with --spAddress
-The command need to set the SP info with --spAddress.
+Get the quota price of the specific sp, the command need to set the sp address with --spAddress.
+Also prints the SP's monthly free read-quota allowance. Pass --global to query the chain-wide
+global store price instead of a single SP's price, in which case --spAddress is not required.
+
+Examples:
+$ gnfd-cmd -c config.toml get-price --spAddress "0x.."
+$ gnfd-cmd -c config.toml get-price --global`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  spAddressFlag,
+				Value: "",
+				Usage: "indicate the storage provider chain address string",
+			},
+			&cli.BoolFlag{
+				Name:  globalFlag,
+				Usage: "query the chain-wide global store price instead of a single SP's price",
+			},
+		},
+	}
+}
+
+// cmdEstimateCost estimates the cost of storing and reading an object of a given size over time
+func cmdEstimateCost() *cli.Command {
+	return &cli.Command{
+		Name:      "estimate-cost",
+		Action:    estimateCost,
+		Usage:     "estimate the storage and read-quota cost for a given size and duration",
+		ArgsUsage: "",
+		Description: `
+Estimate the cost of storing --size bytes and buying --readQuota bytes of read quota for --months
+months at a given SP's price, subtracting the SP's monthly free read quota from the charged read
+portion. Prints a breakdown in BNB and wei so users can plan a buy-quota call ahead of time.
 
 Examples:
-$ gnfd-cmd -c config.toml get-price --spAddress "0x.."`,
+$ gnfd-cmd -c config.toml estimate-cost --size 1000000000 --readQuota 1000000000 --months 3 --spAddress "0x.."`,
 		Flags: []cli.Flag{
+			&cli.Uint64Flag{
+				Name:     sizeFlag,
+				Usage:    "indicate the size of the object to be stored, in bytes",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     readQuotaFlag,
+				Usage:    "indicate the read quota to be bought, in bytes",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:  monthsFlag,
+				Value: 1,
+				Usage: "indicate the number of months to estimate the cost for",
+			},
 			&cli.StringFlag{
 				Name:     spAddressFlag,
 				Value:    "",
@@ -44,15 +132,82 @@ func cmdBuyQuota() *cli.Command {
 		ArgsUsage: "BUCKET-URL",
 		Description: `
 Update the read quota metadata of the bucket, indicating the target quota of the bucket.
-The command need to set the target quota with --chargedQuota 
+The command needs exactly one of --chargedQuota (an absolute target quota), --delta (added to the
+current charged quota) or --topUpToConsumedRatio; combining them is an error. The command is idempotent: if the
+on-chain charged quota already matches the requested value, it no-ops instead of submitting a
+redundant transaction, which makes it safe to drive from CI/CD pipelines applying desired state.
+
+The --quotaMode flag records, as bucket metadata, how storage overruns should be handled: "fifo"
+pairs with running the bucket-gc command to reclaim storage from the oldest objects. Note this is
+about the bucket's storage footprint, not its read/egress quota set by --chargedQuota — the two
+are unrelated on chain. "hard" (an upload-time check rejecting writes that would exceed a storage
+cap) is not supported yet and is rejected with an error rather than accepted and silently ignored.
 
 Examples:
-$ gnfd-cmd -c config.toml buy-quota  --chargedQuota 1000000  gnfd://bucket-name`,
+$ gnfd-cmd -c config.toml buy-quota  --chargedQuota 1000000  gnfd://bucket-name
+$ gnfd-cmd -c config.toml buy-quota  --chargedQuota 1000000 --quotaMode fifo  gnfd://bucket-name
+$ gnfd-cmd -c config.toml buy-quota  --delta 500000  gnfd://bucket-name
+$ gnfd-cmd -c config.toml buy-quota  --topUpToConsumedRatio 0.8  gnfd://bucket-name
+$ gnfd-cmd -c config.toml buy-quota  --chargedQuota 1000000 --broadcastMode async  gnfd://bucket-name`,
 		Flags: []cli.Flag{
 			&cli.Uint64Flag{
-				Name:     chargeQuotaFlag,
-				Usage:    "indicate the target quota to be set for the bucket",
-				Required: true,
+				Name:  chargeQuotaFlag,
+				Usage: "indicate the target quota to be set for the bucket",
+			},
+			&cli.Uint64Flag{
+				Name:  deltaFlag,
+				Usage: "add this many bytes to the bucket's existing charged quota instead of replacing it",
+			},
+			&cli.Float64Flag{
+				Name:  topUpToConsumedRatioFlag,
+				Usage: "compute the smallest chargedQuota such that consumed/charged <= this ratio, only transacting if an increase is needed",
+			},
+			&cli.StringFlag{
+				Name:  broadcastModeFlag,
+				Value: broadcastModeBlock,
+				Usage: "broadcast mode for the buy-quota transaction: sync, async or block",
+			},
+			&cli.StringFlag{
+				Name:  quotaModeFlag,
+				Usage: "indicate the quota enforcement mode of the bucket; only fifo is supported, hard is rejected",
+			},
+		},
+	}
+}
+
+// cmdBucketGC reclaims bucket storage in fifo mode by deleting the oldest objects first
+func cmdBucketGC() *cli.Command {
+	return &cli.Command{
+		Name:      "bucket-gc",
+		Action:    bucketGC,
+		Usage:     "delete the oldest objects in a bucket until its storage size is back under threshold",
+		ArgsUsage: "BUCKET-URL",
+		Description: `
+Reclaim storage for a bucket configured with --quotaMode fifo by deleting objects oldest-first
+until the bucket's total object storage size drops to --storageCap * threshold. The size is
+computed from the bucket's object listing, not from read/egress quota (GetBucketReadQuota), since
+deleting objects does not reduce billed read quota consumption.
+
+--storageCap is persisted as a bucket tag, the same way --quotaMode is: pass it once to configure
+the bucket, and later bucket-gc runs (e.g. from a cron job) can omit it and reuse the persisted
+value. Passing --storageCap again updates the persisted value.
+
+Examples:
+$ gnfd-cmd -c config.toml bucket-gc --storageCap 1000000000 gnfd://bucket-name
+$ gnfd-cmd -c config.toml bucket-gc --dryRun --threshold 0.9 gnfd://bucket-name`,
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{
+				Name:  storageCapFlag,
+				Usage: "indicate the bucket's storage cap in bytes that --threshold is applied against; if omitted, reuses the value persisted by a previous bucket-gc call",
+			},
+			&cli.BoolFlag{
+				Name:  dryRunFlag,
+				Usage: "print the objects that would be deleted without actually deleting them",
+			},
+			&cli.Float64Flag{
+				Name:  thresholdFlag,
+				Value: defaultGCThreshold,
+				Usage: "high-water mark, as a fraction of storageCap, to reclaim down to",
 			},
 		},
 	}
@@ -93,12 +248,40 @@ func buyQuotaForBucket(ctx *cli.Context) error {
 		return toCmdErr(ErrBucketNotExist)
 	}
 
-	targetQuota := ctx.Uint64(chargeQuotaFlag)
-	if targetQuota == 0 {
-		return toCmdErr(errors.New("target quota not set"))
+	quotaMode := ctx.String(quotaModeFlag)
+	switch quotaMode {
+	case "":
+	case quotaModeHard:
+		return toCmdErr(fmt.Errorf("quotaMode %q is not supported yet: no command in this build enforces a storage cap on upload", quotaModeHard))
+	case quotaModeFIFO:
+	default:
+		return toCmdErr(fmt.Errorf("quotaMode must be %s", quotaModeFIFO))
+	}
+
+	currentQuota, err := client.GetBucketReadQuota(c, bucketName)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	targetQuota, err := resolveTargetQuota(ctx, currentQuota)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	if targetQuota == currentQuota.ReadQuotaSize {
+		fmt.Printf("bucket %s is already charged for %d bytes of quota, nothing to do\n", bucketName, targetQuota)
+		if quotaMode != "" {
+			if err = setBucketQuotaMode(c, client, bucketName, quotaMode); err != nil {
+				fmt.Println("failed to persist quota mode:", err.Error())
+			}
+		}
+		return nil
 	}
 
-	broadcastMode := tx.BroadcastMode_BROADCAST_MODE_BLOCK
+	broadcastMode, err := parseBroadcastMode(ctx.String(broadcastModeFlag))
+	if err != nil {
+		return toCmdErr(err)
+	}
 	txnOpt := types.TxOption{Mode: &broadcastMode}
 
 	txnHash, err := client.BuyQuotaForBucket(c, bucketName, targetQuota, sdktypes.BuyQuotaOption{TxOpts: &txnOpt})
@@ -108,10 +291,243 @@ func buyQuotaForBucket(ctx *cli.Context) error {
 		return nil
 	}
 
+	if quotaMode != "" {
+		if err = setBucketQuotaMode(c, client, bucketName, quotaMode); err != nil {
+			fmt.Println("buy quota succeeded but failed to persist quota mode:", err.Error())
+			return nil
+		}
+	}
+
 	fmt.Printf("buy quota for bucket: %s successfully, txn hash: %s\n", bucketName, txnHash)
 	return nil
 }
 
+// resolveTargetQuota computes the absolute target charged quota from --chargedQuota, --delta or
+// --topUpToConsumedRatio, exactly one of which must be set
+func resolveTargetQuota(ctx *cli.Context, currentQuota sdktypes.QuotaInfo) (uint64, error) {
+	absoluteSet := ctx.IsSet(chargeQuotaFlag)
+	deltaSet := ctx.IsSet(deltaFlag)
+	topUpSet := ctx.IsSet(topUpToConsumedRatioFlag)
+
+	switch {
+	case absoluteSet && !deltaSet && !topUpSet:
+		targetQuota := ctx.Uint64(chargeQuotaFlag)
+		if targetQuota == 0 {
+			return 0, errors.New("target quota not set")
+		}
+		return targetQuota, nil
+
+	case deltaSet && !absoluteSet && !topUpSet:
+		return currentQuota.ReadQuotaSize + ctx.Uint64(deltaFlag), nil
+
+	case topUpSet && !absoluteSet && !deltaSet:
+		ratio := ctx.Float64(topUpToConsumedRatioFlag)
+		if ratio <= 0 || ratio > 1 {
+			return 0, errors.New("topUpToConsumedRatio must be in (0, 1]")
+		}
+		required := uint64(math.Ceil(float64(currentQuota.ReadConsumedSize) / ratio))
+		if required <= currentQuota.ReadQuotaSize {
+			return currentQuota.ReadQuotaSize, nil
+		}
+		return required, nil
+
+	default:
+		return 0, errors.New("exactly one of --chargedQuota, --delta or --topUpToConsumedRatio must be set")
+	}
+}
+
+// parseBroadcastMode maps the --broadcastMode flag value to the SDK's tx broadcast mode
+func parseBroadcastMode(mode string) (tx.BroadcastMode, error) {
+	switch mode {
+	case "", broadcastModeBlock:
+		return tx.BroadcastMode_BROADCAST_MODE_BLOCK, nil
+	case broadcastModeSync:
+		return tx.BroadcastMode_BROADCAST_MODE_SYNC, nil
+	case broadcastModeAsync:
+		return tx.BroadcastMode_BROADCAST_MODE_ASYNC, nil
+	default:
+		return tx.BroadcastMode_BROADCAST_MODE_UNSPECIFIED, fmt.Errorf("unknown broadcastMode: %s", mode)
+	}
+}
+
+// setBucketTag upserts a single key/value pair into the bucket's resource tags, preserving any
+// other tags already set on the bucket (quota-mode and storage-cap both share this mechanism, so
+// persisting one must not clobber the other).
+func setBucketTag(ctx context.Context, client gnfdclient.Client, bucketName, key, value string) error {
+	resource := gnfdTypes.NewBucketGRN(bucketName).String()
+
+	merged := make(map[string]string)
+	if existing, err := client.GetTag(ctx, resource); err == nil {
+		for _, tag := range existing.Tags {
+			merged[tag.Key] = tag.Value
+		}
+	}
+	merged[key] = value
+
+	tags := storageTypes.ResourceTags{}
+	for k, v := range merged {
+		tags.Tags = append(tags.Tags, storageTypes.ResourceTags_Tag{Key: k, Value: v})
+	}
+
+	_, err := client.SetTag(ctx, resource, tags)
+	return err
+}
+
+// getBucketTag reads back a single bucket tag value, returning an empty string when the bucket
+// has no such tag (or no tags at all) configured.
+func getBucketTag(ctx context.Context, client gnfdclient.Client, bucketName, key string) (string, error) {
+	resource := gnfdTypes.NewBucketGRN(bucketName).String()
+	tags, err := client.GetTag(ctx, resource)
+	if err != nil {
+		return "", nil
+	}
+
+	for _, tag := range tags.Tags {
+		if tag.Key == key {
+			return tag.Value, nil
+		}
+	}
+	return "", nil
+}
+
+// setBucketQuotaMode persists the chosen quota enforcement mode as a bucket tag
+func setBucketQuotaMode(ctx context.Context, client gnfdclient.Client, bucketName, quotaMode string) error {
+	return setBucketTag(ctx, client, bucketName, quotaModeTagKey, quotaMode)
+}
+
+// getBucketQuotaMode reads back the quota enforcement mode persisted as a bucket tag, returning
+// an empty string when the bucket has no quota mode configured
+func getBucketQuotaMode(ctx context.Context, client gnfdclient.Client, bucketName string) (string, error) {
+	return getBucketTag(ctx, client, bucketName, quotaModeTagKey)
+}
+
+// setBucketStorageCap persists the bucket's storage cap, in bytes, as a bucket tag
+func setBucketStorageCap(ctx context.Context, client gnfdclient.Client, bucketName string, storageCap uint64) error {
+	return setBucketTag(ctx, client, bucketName, storageCapTagKey, strconv.FormatUint(storageCap, 10))
+}
+
+// getBucketStorageCap reads back the storage cap persisted as a bucket tag, returning 0 when the
+// bucket has no storage cap configured
+func getBucketStorageCap(ctx context.Context, client gnfdclient.Client, bucketName string) (uint64, error) {
+	value, err := getBucketTag(ctx, client, bucketName, storageCapTagKey)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// bucketStorageSize sums the payload size of every (non-removed) object in the bucket. This is
+// the bucket's actual storage footprint, which is unrelated to its read/egress quota: deleting
+// objects never reduces ReadConsumedSize (a billing-period counter of bytes already downloaded),
+// so storage reclamation must be measured and driven off object sizes instead.
+func bucketStorageSize(ctx context.Context, client gnfdclient.Client, bucketName string) (uint64, []sdktypes.ObjectDetail, error) {
+	objects, err := client.ListObjects(ctx, bucketName, sdktypes.ListObjectsOptions{ShowRemovedObject: false})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	sort.Slice(objects.Objects, func(i, j int) bool {
+		return objects.Objects[i].ObjectInfo.CreateAt < objects.Objects[j].ObjectInfo.CreateAt
+	})
+
+	var total uint64
+	for _, o := range objects.Objects {
+		total += uint64(o.ObjectInfo.PayloadSize)
+	}
+	return total, objects.Objects, nil
+}
+
+// bucketGC deletes the oldest objects in a bucket, oldest-first, until its total storage size
+// falls back under --storageCap * threshold
+func bucketGC(ctx *cli.Context) error {
+	bucketName, err := getBucketNameByUrl(ctx)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	client, err := NewClient(ctx)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	c, cancelBucketGC := context.WithCancel(globalContext)
+	defer cancelBucketGC()
+
+	_, err = client.HeadBucket(c, bucketName)
+	if err != nil {
+		return toCmdErr(ErrBucketNotExist)
+	}
+
+	storageCap := ctx.Uint64(storageCapFlag)
+	if ctx.IsSet(storageCapFlag) {
+		if storageCap == 0 {
+			return toCmdErr(errors.New("storageCap must be greater than 0"))
+		}
+		if err = setBucketStorageCap(c, client, bucketName, storageCap); err != nil {
+			fmt.Println("failed to persist storageCap:", err.Error())
+		}
+	} else {
+		storageCap, err = getBucketStorageCap(c, client, bucketName)
+		if err != nil {
+			return toCmdErr(err)
+		}
+		if storageCap == 0 {
+			return toCmdErr(errors.New("storageCap not set: pass --storageCap at least once to configure this bucket"))
+		}
+	}
+
+	if mode, modeErr := getBucketQuotaMode(c, client, bucketName); modeErr == nil && mode != quotaModeFIFO {
+		fmt.Printf("warning: bucket %s quota mode is %q, expected %q for bucket-gc\n", bucketName, mode, quotaModeFIFO)
+	}
+
+	threshold := ctx.Float64(thresholdFlag)
+	if threshold <= 0 || threshold > 1 {
+		return toCmdErr(errors.New("threshold must be in (0, 1]"))
+	}
+	dryRun := ctx.Bool(dryRunFlag)
+
+	currentSize, objects, err := bucketStorageSize(c, client, bucketName)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	targetSize := uint64(float64(storageCap) * threshold)
+	if currentSize <= targetSize {
+		fmt.Printf("bucket %s storage size %d is already within threshold %d, nothing to do\n",
+			bucketName, currentSize, targetSize)
+		return nil
+	}
+
+	consumed := currentSize
+	for _, o := range objects {
+		if consumed <= targetSize {
+			break
+		}
+
+		objectName := o.ObjectInfo.ObjectName
+		objectSize := uint64(o.ObjectInfo.PayloadSize)
+
+		if dryRun {
+			fmt.Printf("[dry-run] would delete object %s (%d bytes)\n", objectName, objectSize)
+			consumed -= objectSize
+			continue
+		}
+
+		_, err = client.DeleteObject(c, bucketName, objectName, sdktypes.DeleteObjectOption{})
+		if err != nil {
+			fmt.Printf("failed to delete object %s: %s\n", objectName, err.Error())
+			continue
+		}
+
+		consumed -= objectSize
+		fmt.Printf("deleted object %s (%d bytes), storage size now ~%d\n", objectName, objectSize, consumed)
+	}
+
+	fmt.Printf("bucket-gc finished for bucket %s, estimated storage size: %d (target: %d)\n",
+		bucketName, consumed, targetSize)
+	return nil
+}
+
 // getQuotaPrice query the quota price info of sp from greenfield chain
 func getQuotaPrice(ctx *cli.Context) error {
 	client, err := NewClient(ctx)
@@ -122,6 +538,26 @@ func getQuotaPrice(ctx *cli.Context) error {
 	c, cancelCreateBucket := context.WithCancel(globalContext)
 	defer cancelCreateBucket()
 
+	if ctx.Bool(globalFlag) {
+		globalPrice, err := client.GetGlobalSpStorePriceByTime(c, 0)
+		if err != nil {
+			return toCmdErr(err)
+		}
+
+		readPrice, err := globalPrice.ReadPrice.Float64()
+		if err != nil {
+			return toCmdErr(err)
+		}
+		storePrice, err := globalPrice.StorePrice.Float64()
+		if err != nil {
+			return toCmdErr(err)
+		}
+
+		fmt.Println("get global read quota price:", readPrice, " wei/byte")
+		fmt.Println("get global storage price:", storePrice, " wei/byte")
+		return nil
+	}
+
 	spAddressStr := ctx.String(spAddressFlag)
 	if spAddressStr == "" {
 		return toCmdErr(errors.New("fail to fetch sp address"))
@@ -144,11 +580,84 @@ func getQuotaPrice(ctx *cli.Context) error {
 		return err
 	}
 
+	freeQuota, err := getSPMonthlyFreeQuota(c, client, spAddressStr)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
 	fmt.Println("get bucket read quota price:", quotaPrice, " wei/byte")
 	fmt.Println("get bucket storage price:", storagePrice, " wei/byte")
+	fmt.Println("get sp monthly free read quota:", freeQuota, " bytes")
+	return nil
+}
+
+// getSPMonthlyFreeQuota queries the monthly free read-quota allowance granted by the given SP
+func getSPMonthlyFreeQuota(ctx context.Context, client gnfdclient.Client, spAddress string) (uint64, error) {
+	spInfo, err := client.GetStorageProviderInfo(ctx, spAddress)
+	if err != nil {
+		return 0, err
+	}
+	return spInfo.FreeReadQuota, nil
+}
+
+// estimateCost estimates the storage + read-quota cost of a workload ahead of buying quota
+func estimateCost(ctx *cli.Context) error {
+	client, err := NewClient(ctx)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	c, cancelEstimateCost := context.WithCancel(globalContext)
+	defer cancelEstimateCost()
+
+	spAddressStr := ctx.String(spAddressFlag)
+	size := ctx.Uint64(sizeFlag)
+	readQuota := ctx.Uint64(readQuotaFlag)
+	months := ctx.Uint64(monthsFlag)
+	if months == 0 {
+		months = 1
+	}
+
+	price, err := client.GetStoragePrice(c, spAddressStr)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	storePrice, err := price.StorePrice.Float64()
+	if err != nil {
+		return toCmdErr(err)
+	}
+	readPrice, err := price.ReadPrice.Float64()
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	freeQuota, err := getSPMonthlyFreeQuota(c, client, spAddressStr)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	chargedReadQuota := uint64(0)
+	if readQuota > freeQuota {
+		chargedReadQuota = readQuota - freeQuota
+	}
+
+	storeCostWei := storePrice * float64(size) * float64(months)
+	readCostWei := readPrice * float64(chargedReadQuota) * float64(months)
+	totalCostWei := storeCostWei + readCostWei
+
+	fmt.Printf("estimated cost for %d bytes stored and %d bytes/month read quota over %d month(s):\n", size, readQuota, months)
+	fmt.Printf(" store cost:  %f wei (%f BNB)\n", storeCostWei, weiToBNB(storeCostWei))
+	fmt.Printf(" read cost:   %f wei (%f BNB), after subtracting %d bytes/month free quota\n", readCostWei, weiToBNB(readCostWei), freeQuota)
+	fmt.Printf(" total cost:  %f wei (%f BNB)\n", totalCostWei, weiToBNB(totalCostWei))
 	return nil
 }
 
+// weiToBNB converts a wei amount to BNB, using the standard 18-decimal conversion
+func weiToBNB(wei float64) float64 {
+	return wei / 1e18
+}
+
 // getQuotaInfo query the quota price info of sp from greenfield chain
 func getQuotaInfo(ctx *cli.Context) error {
 	bucketName, err := getBucketNameByUrl(ctx)
@@ -0,0 +1,376 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gnfdclient "github.com/bnb-chain/greenfield-go-sdk/client"
+	sdktypes "github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/bnb-chain/greenfield/sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	intervalFlag = "interval"
+	warnFlag     = "warn"
+	criticalFlag = "critical"
+	webhookFlag  = "webhook"
+	execFlag     = "exec"
+	autoBuyFlag  = "autoBuy"
+
+	defaultWatchInterval = 30 * time.Second
+	defaultWarnRatio     = 0.8
+	defaultCriticalRatio = 0.95
+
+	// autoBuyCooldown is the minimum time between two auto-buy-quota transactions for the same bucket.
+	autoBuyCooldown = time.Minute
+
+	// alertCooldown is the minimum time between two repeated warn/critical alerts for the same
+	// bucket, so a process restart (which reloads persisted state) doesn't immediately re-fire an
+	// alert that was already sent, and a sustained breach doesn't spam the webhook/exec hook on
+	// every poll.
+	alertCooldown = 10 * time.Minute
+
+	// webhookTimeout bounds a single --webhook POST so a slow or hanging endpoint can't stall the
+	// poll loop for every other watched bucket.
+	webhookTimeout = 10 * time.Second
+
+	quotaWatchStateFileName = ".gnfd-quota-watch-state.json"
+)
+
+// quotaWatchAlert is the JSON payload posted to --webhook when a threshold is crossed
+type quotaWatchAlert struct {
+	Bucket    string  `json:"bucket"`
+	Charged   uint64  `json:"charged"`
+	Consumed  uint64  `json:"consumed"`
+	Free      uint64  `json:"free"`
+	Ratio     float64 `json:"ratio"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// quotaWatchBucketState tracks the last alert and auto-buy activity for a single bucket
+type quotaWatchBucketState struct {
+	LastWarnAt     int64 `json:"lastWarnAt"`
+	LastCriticalAt int64 `json:"lastCriticalAt"`
+	LastAutoBuyAt  int64 `json:"lastAutoBuyAt"`
+}
+
+// quotaWatchState is the on-disk state persisted across quota-watch restarts, keyed by bucket name
+type quotaWatchState struct {
+	Buckets map[string]*quotaWatchBucketState `json:"buckets"`
+}
+
+// cmdQuotaWatch polls bucket quota usage and fires alerts/remediation when thresholds are crossed
+func cmdQuotaWatch() *cli.Command {
+	return &cli.Command{
+		Name:      "quota-watch",
+		Action:    quotaWatch,
+		Usage:     "poll bucket quota usage and alert or auto-remediate when thresholds are crossed",
+		ArgsUsage: "BUCKET-URL [BUCKET-URL...]",
+		Description: `
+Poll GetBucketReadQuota for one or more buckets at --interval and trigger actions when the
+consumed/charged ratio crosses --warn or --critical. Actions are pluggable: --webhook posts a
+JSON payload to a URL, --exec runs a shell hook, and --autoBuy automatically buys the given
+amount of additional quota once --critical is crossed, subject to a cooldown so restarts and
+repeated polls don't fire duplicate transactions. Alert state is persisted under the user's home
+directory so restarts don't re-fire the same alert.
+
+Examples:
+$ gnfd-cmd -c config.toml quota-watch --interval 30s --warn 0.8 --critical 0.95 gnfd://bucket-name
+$ gnfd-cmd -c config.toml quota-watch --webhook https://example.com/hook --autoBuy 1000000 gnfd://bucket-name`,
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  intervalFlag,
+				Value: defaultWatchInterval,
+				Usage: "polling interval",
+			},
+			&cli.Float64Flag{
+				Name:  warnFlag,
+				Value: defaultWarnRatio,
+				Usage: "consumed/charged ratio that triggers a warning alert",
+			},
+			&cli.Float64Flag{
+				Name:  criticalFlag,
+				Value: defaultCriticalRatio,
+				Usage: "consumed/charged ratio that triggers a critical alert",
+			},
+			&cli.StringFlag{
+				Name:  webhookFlag,
+				Usage: "URL to POST a JSON alert payload to when a threshold is crossed",
+			},
+			&cli.StringFlag{
+				Name:  execFlag,
+				Usage: "shell command to run when a threshold is crossed",
+			},
+			&cli.Uint64Flag{
+				Name:  autoBuyFlag,
+				Usage: "bytes of additional read quota to buy automatically once the critical threshold is crossed",
+			},
+		},
+	}
+}
+
+// quotaWatch polls the quota of each bucket argument until the process is cancelled
+func quotaWatch(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return toCmdErr(errors.New("at least one bucket url must be provided"))
+	}
+
+	buckets := make([]string, 0, ctx.NArg())
+	for i := 0; i < ctx.NArg(); i++ {
+		bucketName, err := parseBucketURL(ctx.Args().Get(i))
+		if err != nil {
+			return toCmdErr(err)
+		}
+		buckets = append(buckets, bucketName)
+	}
+
+	client, err := NewClient(ctx)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	interval := ctx.Duration(intervalFlag)
+	warn := ctx.Float64(warnFlag)
+	critical := ctx.Float64(criticalFlag)
+	webhook := ctx.String(webhookFlag)
+	execCmd := ctx.String(execFlag)
+	autoBuyDelta := ctx.Uint64(autoBuyFlag)
+
+	state, err := loadQuotaWatchState()
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, bucketName := range buckets {
+			if err = checkBucketQuota(globalContext, client, bucketName, warn, critical, webhook, execCmd, autoBuyDelta, state); err != nil {
+				fmt.Printf("quota-watch: bucket %s check failed: %s\n", bucketName, err.Error())
+			}
+		}
+
+		if err = saveQuotaWatchState(state); err != nil {
+			fmt.Println("quota-watch: failed to persist state:", err.Error())
+		}
+
+		select {
+		case <-globalContext.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkBucketQuota polls a single bucket's quota once and fires the configured actions if needed
+func checkBucketQuota(ctx context.Context, client gnfdclient.Client, bucketName string, warn, critical float64, webhook, execCmd string, autoBuyDelta uint64, state *quotaWatchState) error {
+	quotaInfo, err := client.GetBucketReadQuota(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if quotaInfo.ReadQuotaSize == 0 {
+		return nil
+	}
+
+	ratio := float64(quotaInfo.ReadConsumedSize) / float64(quotaInfo.ReadQuotaSize)
+	bucketState, ok := state.Buckets[bucketName]
+	if !ok {
+		bucketState = &quotaWatchBucketState{}
+		state.Buckets[bucketName] = bucketState
+	}
+
+	now := time.Now()
+	alert := quotaWatchAlert{
+		Bucket:    bucketName,
+		Charged:   quotaInfo.ReadQuotaSize,
+		Consumed:  quotaInfo.ReadConsumedSize,
+		Free:      quotaInfo.SPFreeReadQuotaSize,
+		Ratio:     ratio,
+		Timestamp: now.Unix(),
+	}
+
+	switch {
+	case ratio >= critical:
+		if now.Sub(time.Unix(bucketState.LastCriticalAt, 0)) >= alertCooldown {
+			fmt.Printf("quota-watch: CRITICAL bucket %s ratio %.2f\n", bucketName, ratio)
+			if err = fireQuotaWatchActions(ctx, alert, webhook, execCmd); err != nil {
+				fmt.Println("quota-watch: action failed:", err.Error())
+			}
+			bucketState.LastCriticalAt = now.Unix()
+		}
+
+		if autoBuyDelta > 0 {
+			lastAutoBuy := time.Unix(bucketState.LastAutoBuyAt, 0)
+			if now.Sub(lastAutoBuy) >= autoBuyCooldown {
+				if err = autoBuyQuota(ctx, client, bucketName, quotaInfo.ReadQuotaSize+autoBuyDelta); err != nil {
+					return fmt.Errorf("auto-buy failed: %w", err)
+				}
+				bucketState.LastAutoBuyAt = now.Unix()
+			}
+		}
+	case ratio >= warn:
+		if now.Sub(time.Unix(bucketState.LastWarnAt, 0)) >= alertCooldown {
+			fmt.Printf("quota-watch: WARN bucket %s ratio %.2f\n", bucketName, ratio)
+			if err = fireQuotaWatchActions(ctx, alert, webhook, execCmd); err != nil {
+				fmt.Println("quota-watch: action failed:", err.Error())
+			}
+			bucketState.LastWarnAt = now.Unix()
+		}
+	}
+
+	return nil
+}
+
+// autoBuyQuota tops up a bucket's charged quota by submitting the same SDK BuyQuotaForBucket
+// transaction that buy-quota submits, in block broadcast mode; it does not run buy-quota's
+// idempotency check or quota-mode handling
+func autoBuyQuota(ctx context.Context, client gnfdclient.Client, bucketName string, targetQuota uint64) error {
+	broadcastMode := tx.BroadcastMode_BROADCAST_MODE_BLOCK
+	txnOpt := types.TxOption{Mode: &broadcastMode}
+
+	txnHash, err := client.BuyQuotaForBucket(ctx, bucketName, targetQuota, sdktypes.BuyQuotaOption{TxOpts: &txnOpt})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("quota-watch: auto-bought quota for bucket %s, new target %d, txn hash: %s\n", bucketName, targetQuota, txnHash)
+	return nil
+}
+
+// fireQuotaWatchActions runs the configured webhook and/or exec hook for a single alert
+func fireQuotaWatchActions(ctx context.Context, alert quotaWatchAlert, webhook, execCmd string) error {
+	var firstErr error
+
+	if webhook != "" {
+		if err := postQuotaWatchWebhook(ctx, webhook, alert); err != nil {
+			firstErr = err
+		}
+	}
+
+	if execCmd != "" {
+		if err := runQuotaWatchExec(execCmd, alert); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// postQuotaWatchWebhook POSTs the alert payload as JSON to the configured URL. The request is
+// bound to ctx and capped at webhookTimeout so a slow or hanging endpoint can't stall the poll
+// loop for every other watched bucket.
+func postQuotaWatchWebhook(ctx context.Context, url string, alert quotaWatchAlert) error {
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// runQuotaWatchExec runs the configured shell hook with alert fields exposed as env vars
+func runQuotaWatchExec(shellCmd string, alert quotaWatchAlert) error {
+	cmd := exec.Command("sh", "-c", shellCmd)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("GNFD_QUOTA_BUCKET=%s", alert.Bucket),
+		fmt.Sprintf("GNFD_QUOTA_CHARGED=%d", alert.Charged),
+		fmt.Sprintf("GNFD_QUOTA_CONSUMED=%d", alert.Consumed),
+		fmt.Sprintf("GNFD_QUOTA_RATIO=%f", alert.Ratio),
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// parseBucketURL strips the gnfd:// scheme off a bucket URL argument, since quota-watch accepts
+// multiple bucket arguments rather than the single BUCKET-URL taken by most other commands
+func parseBucketURL(url string) (string, error) {
+	bucketName := strings.TrimPrefix(url, "gnfd://")
+	if bucketName == "" {
+		return "", errors.New("invalid bucket url")
+	}
+	return bucketName, nil
+}
+
+// quotaWatchStatePath returns the path to the persisted quota-watch state file under the user's
+// home directory
+func quotaWatchStatePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, quotaWatchStateFileName), nil
+}
+
+// loadQuotaWatchState reads the persisted quota-watch state, returning an empty state if none
+// exists yet
+func loadQuotaWatchState() (*quotaWatchState, error) {
+	path, err := quotaWatchStatePath()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &quotaWatchState{Buckets: map[string]*quotaWatchBucketState{}}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	if state.Buckets == nil {
+		state.Buckets = map[string]*quotaWatchBucketState{}
+	}
+	return state, nil
+}
+
+// saveQuotaWatchState persists the quota-watch state to disk
+func saveQuotaWatchState(state *quotaWatchState) error {
+	path, err := quotaWatchStatePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
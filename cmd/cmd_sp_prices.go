@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	gnfdclient "github.com/bnb-chain/greenfield-go-sdk/client"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	sortByFlag      = "sortBy"
+	weightReadFlag  = "weightRead"
+	weightStoreFlag = "weightStore"
+	refSizeFlag     = "refSize"
+	refQuotaFlag    = "refQuota"
+	jsonFlag        = "json"
+
+	sortByRead     = "read"
+	sortByStore    = "store"
+	sortByCombined = "combined"
+
+	// spPriceWorkerPoolSize bounds the number of concurrent GetStoragePrice RPCs, since the set of
+	// SPs can be dozens and each call is non-trivial.
+	spPriceWorkerPoolSize = 8
+
+	// includeJailedSPs is passed as ListStorageProviders' second argument, which per the SDK
+	// controls whether jailed/inactive SPs are included in the result. This command ranks only
+	// active SPs, so jailed ones are excluded.
+	includeJailedSPs = false
+)
+
+// spPriceRow is a single row of the list-sp-prices table, one per SP
+type spPriceRow struct {
+	OperatorAddress string  `json:"operatorAddress"`
+	Endpoint        string  `json:"endpoint"`
+	FreeQuota       uint64  `json:"freeQuota"`
+	ReadPrice       float64 `json:"readPrice"`
+	StorePrice      float64 `json:"storePrice"`
+	RefCost         float64 `json:"refCost"`
+	Err             string  `json:"error,omitempty"`
+}
+
+// cmdListSPPrices lists and ranks all active SPs by their quota/storage price
+func cmdListSPPrices() *cli.Command {
+	return &cli.Command{
+		Name:      "list-sp-prices",
+		Action:    listSPPrices,
+		Usage:     "list and rank all active SPs by their quota and storage price",
+		ArgsUsage: "",
+		Description: `
+Enumerate all active SPs, query each one's storage price concurrently, and print a table sorted
+by --sortBy (read, store or combined). The combined score is --weightRead * readPrice +
+--weightStore * storePrice. --refSize and --refQuota compute a reference cost for a workload of
+that size and read quota, so the cheapest SP can be picked with a shell script via --json before
+running create-bucket.
+
+Examples:
+$ gnfd-cmd -c config.toml list-sp-prices --sortBy combined --refSize 1000000000 --refQuota 1000000000
+$ gnfd-cmd -c config.toml list-sp-prices --json`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  sortByFlag,
+				Value: sortByCombined,
+				Usage: "sort key: read, store or combined",
+			},
+			&cli.Float64Flag{
+				Name:  weightReadFlag,
+				Value: 1,
+				Usage: "weight applied to read price for the combined score",
+			},
+			&cli.Float64Flag{
+				Name:  weightStoreFlag,
+				Value: 1,
+				Usage: "weight applied to store price for the combined score",
+			},
+			&cli.Uint64Flag{
+				Name:  refSizeFlag,
+				Usage: "reference workload size in bytes, used to compute a reference cost column",
+			},
+			&cli.Uint64Flag{
+				Name:  refQuotaFlag,
+				Usage: "reference read quota in bytes, used to compute a reference cost column",
+			},
+			&cli.BoolFlag{
+				Name:  jsonFlag,
+				Usage: "print machine-readable JSON instead of a table",
+			},
+		},
+	}
+}
+
+// listSPPrices queries every active SP's storage price concurrently and prints a ranked table
+func listSPPrices(ctx *cli.Context) error {
+	client, err := NewClient(ctx)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	c, cancelListSPPrices := context.WithCancel(globalContext)
+	defer cancelListSPPrices()
+
+	sps, err := client.ListStorageProviders(c, includeJailedSPs)
+	if err != nil {
+		return toCmdErr(err)
+	}
+
+	sortBy := ctx.String(sortByFlag)
+	weightRead := ctx.Float64(weightReadFlag)
+	weightStore := ctx.Float64(weightStoreFlag)
+	refSize := ctx.Uint64(refSizeFlag)
+	refQuota := ctx.Uint64(refQuotaFlag)
+
+	rows := fetchSPPriceRows(c, client, sps, refSize, refQuota)
+	sortSPPriceRows(rows, sortBy, weightRead, weightStore)
+
+	if ctx.Bool(jsonFlag) {
+		out, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return toCmdErr(err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	printSPPriceTable(rows)
+	return nil
+}
+
+// fetchSPPriceRows queries GetStoragePrice for every SP concurrently, bounded by a worker pool
+func fetchSPPriceRows(ctx context.Context, client gnfdclient.Client, sps []sptypes.StorageProvider, refSize, refQuota uint64) []spPriceRow {
+	rows := make([]spPriceRow, len(sps))
+	sem := make(chan struct{}, spPriceWorkerPoolSize)
+	var wg sync.WaitGroup
+
+	for i, spInfo := range sps {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, spInfo sptypes.StorageProvider) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			rows[i] = buildSPPriceRow(ctx, client, spInfo, refSize, refQuota)
+		}(i, spInfo)
+	}
+
+	wg.Wait()
+	return rows
+}
+
+// buildSPPriceRow queries a single SP's price and free quota and computes its reference cost
+func buildSPPriceRow(ctx context.Context, client gnfdclient.Client, spInfo sptypes.StorageProvider, refSize, refQuota uint64) spPriceRow {
+	row := spPriceRow{
+		OperatorAddress: spInfo.OperatorAddress,
+		Endpoint:        spInfo.Endpoint,
+	}
+
+	price, err := client.GetStoragePrice(ctx, spInfo.OperatorAddress)
+	if err != nil {
+		row.Err = err.Error()
+		return row
+	}
+
+	row.ReadPrice, err = price.ReadPrice.Float64()
+	if err != nil {
+		row.Err = err.Error()
+		return row
+	}
+	row.StorePrice, err = price.StorePrice.Float64()
+	if err != nil {
+		row.Err = err.Error()
+		return row
+	}
+
+	freeQuota, err := getSPMonthlyFreeQuota(ctx, client, spInfo.OperatorAddress)
+	if err != nil {
+		row.Err = err.Error()
+		return row
+	}
+	row.FreeQuota = freeQuota
+
+	chargedQuota := uint64(0)
+	if refQuota > freeQuota {
+		chargedQuota = refQuota - freeQuota
+	}
+	row.RefCost = row.StorePrice*float64(refSize) + row.ReadPrice*float64(chargedQuota)
+
+	return row
+}
+
+// sortSPPriceRows sorts rows in place by the requested key, pushing errored rows to the end
+func sortSPPriceRows(rows []spPriceRow, sortBy string, weightRead, weightStore float64) {
+	score := func(r spPriceRow) float64 {
+		switch sortBy {
+		case sortByRead:
+			return r.ReadPrice
+		case sortByStore:
+			return r.StorePrice
+		default:
+			return weightRead*r.ReadPrice + weightStore*r.StorePrice
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if (rows[i].Err != "") != (rows[j].Err != "") {
+			return rows[i].Err == ""
+		}
+		return score(rows[i]) < score(rows[j])
+	})
+}
+
+// printSPPriceTable prints the ranked SP price rows as a human-readable table
+func printSPPriceTable(rows []spPriceRow) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "OPERATOR\tENDPOINT\tFREE QUOTA\tREAD PRICE\tSTORE PRICE\tREF COST\tERROR")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%f\t%f\t%f\t%s\n",
+			r.OperatorAddress, r.Endpoint, r.FreeQuota, r.ReadPrice, r.StorePrice, r.RefCost, r.Err)
+	}
+	w.Flush()
+}